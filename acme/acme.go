@@ -0,0 +1,237 @@
+// Package acme provides a lego-compatible ACME DNS-01 challenge.Provider
+// backed by the Hetzner DNS API.
+package acme
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/go-acme/lego/v4/challenge"
+	"github.com/go-acme/lego/v4/challenge/dns01"
+
+	hetzner "github.com/StarHack/go-hetzner-dns"
+)
+
+const (
+	defaultPropagationTimeout = 2 * time.Minute
+	defaultPollingInterval    = 5 * time.Second
+)
+
+// Config configures a DNSProvider.
+//
+// There is no TTL setting: the Hetzner DNS API's record create/update endpoints don't accept
+// a TTL, so one can't meaningfully be applied per challenge record.
+type Config struct {
+	APIKey             string
+	APIBaseUrl         string
+	PropagationTimeout time.Duration
+	PollingInterval    time.Duration
+}
+
+// NewDefaultConfig returns a Config populated with sane defaults.
+func NewDefaultConfig() *Config {
+	return &Config{
+		PropagationTimeout: defaultPropagationTimeout,
+		PollingInterval:    defaultPollingInterval,
+	}
+}
+
+// DNSProvider implements challenge.Provider for the Hetzner DNS API.
+type DNSProvider struct {
+	client *hetzner.Hetzner
+	config *Config
+}
+
+// NewDNSProvider returns a DNSProvider configured for Hetzner DNS using the given API key.
+func NewDNSProvider(apiKey string) (*DNSProvider, error) {
+	config := NewDefaultConfig()
+	config.APIKey = apiKey
+
+	return NewDNSProviderConfig(config)
+}
+
+// NewDNSProviderConfig returns a DNSProvider configured according to config.
+func NewDNSProviderConfig(config *Config) (*DNSProvider, error) {
+	if config == nil {
+		return nil, errors.New("hetzner: the configuration of the DNS provider is nil")
+	}
+	if config.APIKey == "" {
+		return nil, errors.New("hetzner: API key is missing")
+	}
+
+	return &DNSProvider{
+		client: &hetzner.Hetzner{APIKey: config.APIKey, APIBaseUrl: config.APIBaseUrl},
+		config: config,
+	}, nil
+}
+
+// Timeout returns the timeout and interval lego should use to poll for DNS propagation.
+func (d *DNSProvider) Timeout() (timeout, interval time.Duration) {
+	return d.config.PropagationTimeout, d.config.PollingInterval
+}
+
+// Present creates the TXT record required to fulfill the dns-01 challenge for domain, then
+// polls the zone's authoritative nameservers until the record is visible there (or
+// PropagationTimeout elapses) before returning.
+func (d *DNSProvider) Present(domain, token, keyAuth string) error {
+	fqdn, value := dns01.GetRecord(domain, keyAuth)
+
+	zoneID, zoneName, recordName, err := d.findZoneAndRecordName(fqdn)
+	if err != nil {
+		return fmt.Errorf("hetzner: %w", err)
+	}
+
+	if err := d.client.CreateRecord(zoneID, "TXT", recordName, value); err != nil {
+		return fmt.Errorf("hetzner: failed to create TXT record: %w", err)
+	}
+
+	if err := d.waitForPropagation(zoneName, fqdn, value); err != nil {
+		return fmt.Errorf("hetzner: %w", err)
+	}
+
+	return nil
+}
+
+// waitForPropagation polls zoneName's authoritative nameservers directly (bypassing any
+// recursive resolver cache) until all of them answer fqdn's TXT query with value, or
+// PropagationTimeout elapses.
+func (d *DNSProvider) waitForPropagation(zoneName, fqdn, value string) error {
+	servers, err := authoritativeNameservers(zoneName)
+	if err != nil {
+		return fmt.Errorf("failed to resolve authoritative nameservers for %s: %w", zoneName, err)
+	}
+
+	deadline := time.Now().Add(d.config.PropagationTimeout)
+	for {
+		if allServersHaveTXT(servers, fqdn, value) {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("TXT record for %s did not propagate to %v within %s", fqdn, servers, d.config.PropagationTimeout)
+		}
+		time.Sleep(d.config.PollingInterval)
+	}
+}
+
+// authoritativeNameservers returns the nameserver hosts authoritative for zoneName, as
+// reported by the system resolver.
+func authoritativeNameservers(zoneName string) ([]string, error) {
+	nsRecords, err := net.LookupNS(dns01.UnFqdn(zoneName))
+	if err != nil {
+		return nil, err
+	}
+	if len(nsRecords) == 0 {
+		return nil, fmt.Errorf("no nameservers found for %s", zoneName)
+	}
+
+	servers := make([]string, len(nsRecords))
+	for i, ns := range nsRecords {
+		servers[i] = ns.Host
+	}
+	return servers, nil
+}
+
+// allServersHaveTXT reports whether every nameserver in servers currently answers fqdn's TXT
+// query with a record equal to value.
+func allServersHaveTXT(servers []string, fqdn, value string) bool {
+	for _, server := range servers {
+		if !serverHasTXT(server, fqdn, value) {
+			return false
+		}
+	}
+	return true
+}
+
+// serverHasTXT queries server directly for fqdn's TXT records and reports whether one of them
+// equals value.
+func serverHasTXT(server, fqdn, value string) bool {
+	resolver := &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, network, address string) (net.Conn, error) {
+			dialer := net.Dialer{Timeout: 5 * time.Second}
+			return dialer.DialContext(ctx, network, net.JoinHostPort(server, "53"))
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	records, err := resolver.LookupTXT(ctx, dns01.UnFqdn(fqdn))
+	if err != nil {
+		return false
+	}
+	for _, record := range records {
+		if record == value {
+			return true
+		}
+	}
+	return false
+}
+
+// CleanUp removes the TXT record created by Present.
+func (d *DNSProvider) CleanUp(domain, token, keyAuth string) error {
+	fqdn, value := dns01.GetRecord(domain, keyAuth)
+
+	zoneID, _, recordName, err := d.findZoneAndRecordName(fqdn)
+	if err != nil {
+		return fmt.Errorf("hetzner: %w", err)
+	}
+
+	records, err := d.client.FindRecordsByName(zoneID, recordName)
+	if err != nil {
+		return fmt.Errorf("hetzner: failed to look up TXT record: %w", err)
+	}
+
+	for _, record := range records {
+		if record.Type != "TXT" || record.Value != value {
+			continue
+		}
+		if err := d.client.DeleteRecord(record.ID); err != nil {
+			return fmt.Errorf("hetzner: failed to delete TXT record: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// findZoneAndRecordName resolves the Hetzner zone that should hold fqdn by walking up its
+// labels and matching against the zones visible to the configured API key (longest-suffix,
+// case-insensitive match), then derives the record name relative to that zone.
+func (d *DNSProvider) findZoneAndRecordName(fqdn string) (zoneID, zoneName, recordName string, err error) {
+	zones, err := d.client.FindAllZones()
+	if err != nil {
+		return "", "", "", fmt.Errorf("failed to list zones: %w", err)
+	}
+
+	authZone := strings.ToLower(dns01.UnFqdn(fqdn))
+
+	var bestZone *hetzner.Zone
+	for i := range zones {
+		name := strings.ToLower(strings.TrimSuffix(zones[i].Name, "."))
+		if authZone != name && !strings.HasSuffix(authZone, "."+name) {
+			continue
+		}
+		if bestZone == nil || len(name) > len(strings.ToLower(strings.TrimSuffix(bestZone.Name, "."))) {
+			bestZone = &zones[i]
+		}
+	}
+
+	if bestZone == nil {
+		return "", "", "", fmt.Errorf("no zone found for %s", fqdn)
+	}
+
+	zoneName = strings.ToLower(strings.TrimSuffix(bestZone.Name, "."))
+	recordName = strings.TrimSuffix(authZone, zoneName)
+	recordName = strings.TrimSuffix(recordName, ".")
+	if recordName == "" {
+		recordName = "@"
+	}
+
+	return bestZone.ID, zoneName, recordName, nil
+}
+
+var _ challenge.Provider = (*DNSProvider)(nil)
@@ -0,0 +1,94 @@
+package acme
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	hetzner "github.com/StarHack/go-hetzner-dns"
+)
+
+func newZonesTestServer(t *testing.T, zones []hetzner.Zone) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/zones" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		json.NewEncoder(w).Encode(struct {
+			Zones []hetzner.Zone `json:"zones"`
+		}{Zones: zones})
+	}))
+}
+
+func TestFindZoneAndRecordName(t *testing.T) {
+	zones := []hetzner.Zone{
+		{ID: "zone-example", Name: "example.com"},
+		{ID: "zone-sub", Name: "sub.example.com"},
+	}
+
+	server := newZonesTestServer(t, zones)
+	defer server.Close()
+
+	provider := &DNSProvider{
+		client: &hetzner.Hetzner{APIKey: "key", APIBaseUrl: server.URL},
+		config: NewDefaultConfig(),
+	}
+
+	tests := []struct {
+		name           string
+		fqdn           string
+		wantZoneID     string
+		wantZoneName   string
+		wantRecordName string
+	}{
+		{
+			name:           "apex challenge",
+			fqdn:           "_acme-challenge.example.com.",
+			wantZoneID:     "zone-example",
+			wantZoneName:   "example.com",
+			wantRecordName: "_acme-challenge",
+		},
+		{
+			name:           "matches the longer, more specific zone",
+			fqdn:           "_acme-challenge.sub.example.com.",
+			wantZoneID:     "zone-sub",
+			wantZoneName:   "sub.example.com",
+			wantRecordName: "_acme-challenge",
+		},
+		{
+			name:           "nested label under the matched zone",
+			fqdn:           "_acme-challenge.www.example.com.",
+			wantZoneID:     "zone-example",
+			wantZoneName:   "example.com",
+			wantRecordName: "_acme-challenge.www",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			zoneID, zoneName, recordName, err := provider.findZoneAndRecordName(tt.fqdn)
+			if err != nil {
+				t.Fatalf("findZoneAndRecordName(%q) returned error: %v", tt.fqdn, err)
+			}
+			if zoneID != tt.wantZoneID || zoneName != tt.wantZoneName || recordName != tt.wantRecordName {
+				t.Errorf("findZoneAndRecordName(%q) = (%q, %q, %q), want (%q, %q, %q)",
+					tt.fqdn, zoneID, zoneName, recordName, tt.wantZoneID, tt.wantZoneName, tt.wantRecordName)
+			}
+		})
+	}
+}
+
+func TestFindZoneAndRecordNameNoMatch(t *testing.T) {
+	server := newZonesTestServer(t, []hetzner.Zone{{ID: "zone-example", Name: "example.com"}})
+	defer server.Close()
+
+	provider := &DNSProvider{
+		client: &hetzner.Hetzner{APIKey: "key", APIBaseUrl: server.URL},
+		config: NewDefaultConfig(),
+	}
+
+	if _, _, _, err := provider.findZoneAndRecordName("_acme-challenge.other.org."); err == nil {
+		t.Error("findZoneAndRecordName returned no error for a domain with no matching zone")
+	}
+}
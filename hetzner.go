@@ -2,19 +2,137 @@ package hetzner
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"math/rand"
 	"net/http"
+	"net/url"
 	"os"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 )
 
+// defaultHTTPClient is used whenever a Hetzner value doesn't set its own HTTPClient.
+var defaultHTTPClient = &http.Client{Timeout: 30 * time.Second}
+
+// defaultMaxRetries is used whenever a Hetzner value doesn't set its own MaxRetries.
+const defaultMaxRetries = 3
+
 type Hetzner struct {
 	APIKey     string
 	APIBaseUrl string
+	HTTPClient *http.Client
+
+	// UserAgent, when set, is sent as the User-Agent header on every request.
+	UserAgent string
+
+	// Logger receives request/response diagnostics (method, URL, status, duration) from do.
+	// A nil Logger, the zero value, disables logging.
+	Logger Logger
+
+	// MaxRetries caps the number of retry attempts performed by do for rate-limited (429),
+	// transiently unavailable (5xx), and network-level failures. Zero uses defaultMaxRetries.
+	MaxRetries int
+
+	// RetryPolicy controls whether non-idempotent requests (POST, PUT) are eligible for retry.
+	RetryPolicy RetryPolicy
+}
+
+// Logger is the minimal logging interface Hetzner uses for request/response diagnostics.
+// Implementations are expected to be safe for concurrent use; wire in WithLogger.
+type Logger interface {
+	Debugf(format string, args ...interface{})
+	Infof(format string, args ...interface{})
+	Warnf(format string, args ...interface{})
+}
+
+// Option configures a Hetzner client constructed with NewClient.
+type Option func(*Hetzner)
+
+// WithBaseURL overrides the default Hetzner DNS API base URL.
+func WithBaseURL(baseURL string) Option {
+	return func(h *Hetzner) { h.APIBaseUrl = baseURL }
+}
+
+// WithHTTPClient sets the http.Client used for every request, instead of the shared default.
+func WithHTTPClient(client *http.Client) Option {
+	return func(h *Hetzner) { h.HTTPClient = client }
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+func WithUserAgent(userAgent string) Option {
+	return func(h *Hetzner) { h.UserAgent = userAgent }
+}
+
+// WithTimeout sets the timeout on the client's http.Client. If an http.Client is already set
+// (e.g. by an earlier WithHTTPClient), WithTimeout applies to a shallow copy of it, preserving
+// its Transport, CookieJar, etc. without mutating the original, possibly shared, *http.Client.
+// Like all Options, order matters: a WithHTTPClient applied after WithTimeout still fully
+// replaces h.HTTPClient and drops the timeout set here.
+func WithTimeout(timeout time.Duration) Option {
+	return func(h *Hetzner) {
+		client := http.Client{}
+		if h.HTTPClient != nil {
+			client = *h.HTTPClient
+		}
+		client.Timeout = timeout
+		h.HTTPClient = &client
+	}
+}
+
+// WithLogger wires logger into request/response logging. do reports method, URL, status, and
+// duration for every attempt; the API token is never logged.
+func WithLogger(logger Logger) Option {
+	return func(h *Hetzner) { h.Logger = logger }
+}
+
+// WithRetryPolicy overrides the default RetryPolicy, which only retries GET and DELETE.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(h *Hetzner) { h.RetryPolicy = policy }
+}
+
+// NewClient is the canonical way to construct a Hetzner client. apiKey is required; opts
+// configure the base URL, HTTP client, user agent, retry behavior, and logging. Direct struct
+// initialization (Hetzner{APIKey: ...}) remains supported for backward compatibility.
+func NewClient(apiKey string, opts ...Option) (*Hetzner, error) {
+	if apiKey == "" {
+		return nil, errors.New("hetzner: API key is required")
+	}
+
+	h := &Hetzner{APIKey: apiKey}
+	for _, opt := range opts {
+		opt(h)
+	}
+
+	return h, nil
+}
+
+// RetryPolicy controls which requests do is allowed to retry. GET and DELETE are always
+// considered safe to retry; POST and PUT are only retried when explicitly opted into here.
+type RetryPolicy struct {
+	RetryPOST bool
+	RetryPUT  bool
+}
+
+// APIError is returned whenever the Hetzner DNS API responds with a non-2xx status. Callers
+// can use errors.As to recover the status code, the parsed error message, and the request ID
+// Hetzner assigned to the failed request, instead of matching on the error string.
+type APIError struct {
+	StatusCode int
+	Message    string
+	RequestID  string
+}
+
+func (e *APIError) Error() string {
+	if e.RequestID != "" {
+		return fmt.Sprintf("API request failed with status %d (request %s): %s", e.StatusCode, e.RequestID, e.Message)
+	}
+	return fmt.Sprintf("API request failed with status %d: %s", e.StatusCode, e.Message)
 }
 
 // A DNS zone in Hetzner's API response
@@ -65,20 +183,19 @@ type PrimaryServer struct {
 }
 
 // Finds all zones accessible by the current API key
-func (h *Hetzner) FindAllZones() ([]Zone, error) {
+func (h *Hetzner) FindAllZonesContext(ctx context.Context) ([]Zone, error) {
 	url := fmt.Sprintf("%s/zones", h.apiBaseURL())
 
-	req, err := http.NewRequest("GET", url, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return []Zone{}, fmt.Errorf("failed to create request: %w", err)
 	}
 
 	req.Header.Set("Auth-API-Token", h.APIKey)
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := h.do(req)
 	if err != nil {
-		return []Zone{}, fmt.Errorf("failed to execute request: %w", err)
+		return []Zone{}, err
 	}
 	defer resp.Body.Close()
 
@@ -95,9 +212,109 @@ func (h *Hetzner) FindAllZones() ([]Zone, error) {
 	return zonesResponse.Zones, nil
 }
 
+// Finds all zones accessible by the current API key
+func (h *Hetzner) FindAllZones() ([]Zone, error) {
+	return h.FindAllZonesContext(context.Background())
+}
+
+// Pagination mirrors the meta.pagination block returned by Hetzner's paginated list endpoints.
+type Pagination struct {
+	Page         int `json:"page"`
+	PerPage      int `json:"per_page"`
+	LastPage     int `json:"last_page"`
+	TotalEntries int `json:"total_entries"`
+}
+
+// ListZonesOptions configures a single page of FindAllZonesPaged. Page and PerPage are
+// omitted from the request when left at zero, falling back to Hetzner's own defaults.
+type ListZonesOptions struct {
+	Page       int
+	PerPage    int
+	SearchName string
+}
+
+// Fetches a single page of zones accessible by the current API key, honoring opts as
+// server-side page, per_page, and search_name query parameters
+func (h *Hetzner) FindAllZonesPagedContext(ctx context.Context, opts ListZonesOptions) ([]Zone, Pagination, error) {
+	query := url.Values{}
+	if opts.Page > 0 {
+		query.Set("page", strconv.Itoa(opts.Page))
+	}
+	if opts.PerPage > 0 {
+		query.Set("per_page", strconv.Itoa(opts.PerPage))
+	}
+	if opts.SearchName != "" {
+		query.Set("search_name", opts.SearchName)
+	}
+
+	reqURL := fmt.Sprintf("%s/zones", h.apiBaseURL())
+	if encoded := query.Encode(); encoded != "" {
+		reqURL += "?" + encoded
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", reqURL, nil)
+	if err != nil {
+		return nil, Pagination{}, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Auth-API-Token", h.APIKey)
+
+	resp, err := h.do(req)
+	if err != nil {
+		return nil, Pagination{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, Pagination{}, h.createApiErrorMessage(resp)
+	}
+
+	var zonesResponse struct {
+		Zones []Zone `json:"zones"`
+		Meta  struct {
+			Pagination Pagination `json:"pagination"`
+		} `json:"meta"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&zonesResponse); err != nil {
+		return nil, Pagination{}, fmt.Errorf("failed to decode response body: %w", err)
+	}
+
+	return zonesResponse.Zones, zonesResponse.Meta.Pagination, nil
+}
+
+// Fetches a single page of zones accessible by the current API key, honoring opts as
+// server-side page, per_page, and search_name query parameters
+func (h *Hetzner) FindAllZonesPaged(opts ListZonesOptions) ([]Zone, Pagination, error) {
+	return h.FindAllZonesPagedContext(context.Background(), opts)
+}
+
+// IterateAllZones walks every zone accessible by the current API key, transparently fetching
+// one page at a time, and invokes fn for each zone. It stops and returns fn's error as soon
+// as fn returns a non-nil one.
+func (h *Hetzner) IterateAllZones(ctx context.Context, fn func(Zone) error) error {
+	opts := ListZonesOptions{Page: 1, PerPage: 100}
+	for {
+		zones, pagination, err := h.FindAllZonesPagedContext(ctx, opts)
+		if err != nil {
+			return err
+		}
+
+		for _, zone := range zones {
+			if err := fn(zone); err != nil {
+				return err
+			}
+		}
+
+		if pagination.LastPage == 0 || opts.Page >= pagination.LastPage {
+			return nil
+		}
+		opts.Page++
+	}
+}
+
 // Finds the ID of the DNS zone for a given domain name
-func (h *Hetzner) FindZoneID(domainName string) (string, error) {
-	zones, err := h.FindAllZones()
+func (h *Hetzner) FindZoneIDContext(ctx context.Context, domainName string) (string, error) {
+	zones, err := h.FindAllZonesContext(ctx)
 	if err != nil {
 		return "", err
 	}
@@ -111,21 +328,25 @@ func (h *Hetzner) FindZoneID(domainName string) (string, error) {
 	return "", fmt.Errorf("zone for domain %s not found", domainName)
 }
 
+// Finds the ID of the DNS zone for a given domain name
+func (h *Hetzner) FindZoneID(domainName string) (string, error) {
+	return h.FindZoneIDContext(context.Background(), domainName)
+}
+
 // Fetches all DNS records for the specified zone ID
-func (h *Hetzner) FindAllRecordsForZone(zoneID string) ([]RecordResponse, error) {
+func (h *Hetzner) FindAllRecordsForZoneContext(ctx context.Context, zoneID string) ([]RecordResponse, error) {
 	url := fmt.Sprintf("%s/records?zone_id=%s", h.apiBaseURL(), zoneID)
 
-	req, err := http.NewRequest("GET", url, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
 	req.Header.Set("Auth-API-Token", h.APIKey)
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := h.do(req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to execute request: %w", err)
+		return nil, err
 	}
 	defer resp.Body.Close()
 
@@ -143,24 +364,133 @@ func (h *Hetzner) FindAllRecordsForZone(zoneID string) ([]RecordResponse, error)
 	return recordsResponse.Records, nil
 }
 
-// Finds all records matching a specific name (i.e. _acme-challenge)
-func (h *Hetzner) FindRecordsByName(zoneID string, recordName string) ([]RecordResponse, error) {
-	records, err := h.FindAllRecordsForZone(zoneID)
+// Fetches all DNS records for the specified zone ID
+func (h *Hetzner) FindAllRecordsForZone(zoneID string) ([]RecordResponse, error) {
+	return h.FindAllRecordsForZoneContext(context.Background(), zoneID)
+}
+
+// ListRecordsOptions configures a single page of FindAllRecordsForZonePaged. Page and PerPage
+// are omitted from the request when left at zero, falling back to Hetzner's own defaults.
+type ListRecordsOptions struct {
+	ZoneID  string
+	Name    string
+	Page    int
+	PerPage int
+}
+
+// Fetches a single page of DNS records for the specified zone, honoring opts as server-side
+// page, per_page, and name query parameters
+func (h *Hetzner) FindAllRecordsForZonePagedContext(ctx context.Context, opts ListRecordsOptions) ([]RecordResponse, Pagination, error) {
+	query := url.Values{}
+	query.Set("zone_id", opts.ZoneID)
+	if opts.Name != "" {
+		query.Set("name", opts.Name)
+	}
+	if opts.Page > 0 {
+		query.Set("page", strconv.Itoa(opts.Page))
+	}
+	if opts.PerPage > 0 {
+		query.Set("per_page", strconv.Itoa(opts.PerPage))
+	}
+
+	reqURL := fmt.Sprintf("%s/records?%s", h.apiBaseURL(), query.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, "GET", reqURL, nil)
 	if err != nil {
-		return nil, err
+		return nil, Pagination{}, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Auth-API-Token", h.APIKey)
+
+	resp, err := h.do(req)
+	if err != nil {
+		return nil, Pagination{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, Pagination{}, h.createApiErrorMessage(resp)
+	}
+
+	var recordsResponse struct {
+		Records []RecordResponse `json:"records"`
+		Meta    struct {
+			Pagination Pagination `json:"pagination"`
+		} `json:"meta"`
 	}
+	if err := json.NewDecoder(resp.Body).Decode(&recordsResponse); err != nil {
+		return nil, Pagination{}, fmt.Errorf("failed to decode response body: %w", err)
+	}
+
+	return recordsResponse.Records, recordsResponse.Meta.Pagination, nil
+}
+
+// Fetches a single page of DNS records for the specified zone, honoring opts as server-side
+// page, per_page, and name query parameters
+func (h *Hetzner) FindAllRecordsForZonePaged(opts ListRecordsOptions) ([]RecordResponse, Pagination, error) {
+	return h.FindAllRecordsForZonePagedContext(context.Background(), opts)
+}
+
+// IterateAllRecordsForZone walks every DNS record in zoneID, transparently fetching one page
+// at a time, and invokes fn for each record. It stops and returns fn's error as soon as fn
+// returns a non-nil one.
+func (h *Hetzner) IterateAllRecordsForZone(ctx context.Context, zoneID string, fn func(RecordResponse) error) error {
+	opts := ListRecordsOptions{ZoneID: zoneID, Page: 1, PerPage: 100}
+	for {
+		records, pagination, err := h.FindAllRecordsForZonePagedContext(ctx, opts)
+		if err != nil {
+			return err
+		}
+
+		for _, record := range records {
+			if err := fn(record); err != nil {
+				return err
+			}
+		}
+
+		if pagination.LastPage == 0 || opts.Page >= pagination.LastPage {
+			return nil
+		}
+		opts.Page++
+	}
+}
+
+// Finds all records matching a specific name (i.e. _acme-challenge). The search is pushed
+// down to Hetzner's server-side name query parameter so large zones don't require fetching
+// every record, with a client-side EqualFold pass to guard against non-exact server matches.
+func (h *Hetzner) FindRecordsByNameContext(ctx context.Context, zoneID string, recordName string) ([]RecordResponse, error) {
 	var matchingRecords []RecordResponse
-	for _, record := range records {
-		if strings.EqualFold(record.Name, recordName) {
-			matchingRecords = append(matchingRecords, record)
+
+	opts := ListRecordsOptions{ZoneID: zoneID, Name: recordName, Page: 1, PerPage: 100}
+	for {
+		records, pagination, err := h.FindAllRecordsForZonePagedContext(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, record := range records {
+			if strings.EqualFold(record.Name, recordName) {
+				matchingRecords = append(matchingRecords, record)
+			}
+		}
+
+		if pagination.LastPage == 0 || opts.Page >= pagination.LastPage {
+			break
 		}
+		opts.Page++
 	}
+
 	return matchingRecords, nil
 }
 
+// Finds all records matching a specific name (i.e. _acme-challenge)
+func (h *Hetzner) FindRecordsByName(zoneID string, recordName string) ([]RecordResponse, error) {
+	return h.FindRecordsByNameContext(context.Background(), zoneID, recordName)
+}
+
 // Finds a DNS record by a passed ID
-func (h *Hetzner) FindRecordById(zoneID string, recordId string) (RecordResponse, error) {
-	records, err := h.FindAllRecordsForZone(zoneID)
+func (h *Hetzner) FindRecordByIdContext(ctx context.Context, zoneID string, recordId string) (RecordResponse, error) {
+	records, err := h.FindAllRecordsForZoneContext(ctx, zoneID)
 	if err != nil {
 		return RecordResponse{}, err
 	}
@@ -172,6 +502,11 @@ func (h *Hetzner) FindRecordById(zoneID string, recordId string) (RecordResponse
 	return RecordResponse{}, errors.New("record not found")
 }
 
+// Finds a DNS record by a passed ID
+func (h *Hetzner) FindRecordById(zoneID string, recordId string) (RecordResponse, error) {
+	return h.FindRecordByIdContext(context.Background(), zoneID, recordId)
+}
+
 // Prints all the passed records. Used only for debugging.
 func (h *Hetzner) PrintRecords(records []RecordResponse) {
 	for _, record := range records {
@@ -181,7 +516,7 @@ func (h *Hetzner) PrintRecords(records []RecordResponse) {
 }
 
 // Updates an existing DNS record with new information
-func (h *Hetzner) UpdateRecord(zoneID, recordID, recordType, recordName, recordValue string) error {
+func (h *Hetzner) UpdateRecordContext(ctx context.Context, zoneID, recordID, recordType, recordName, recordValue string) error {
 	url := fmt.Sprintf("%s/records/%s", h.apiBaseURL(), recordID)
 
 	updatedRecord := RecordUpdateRequest{
@@ -197,7 +532,7 @@ func (h *Hetzner) UpdateRecord(zoneID, recordID, recordType, recordName, recordV
 		return fmt.Errorf("failed to marshal request body: %w", err)
 	}
 
-	req, err := http.NewRequest("PUT", url, bytes.NewBuffer(requestBody))
+	req, err := http.NewRequestWithContext(ctx, "PUT", url, bytes.NewBuffer(requestBody))
 	if err != nil {
 		return fmt.Errorf("failed to create request: %w", err)
 	}
@@ -205,10 +540,9 @@ func (h *Hetzner) UpdateRecord(zoneID, recordID, recordType, recordName, recordV
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Auth-API-Token", h.APIKey)
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := h.do(req)
 	if err != nil {
-		return fmt.Errorf("failed to execute request: %w", err)
+		return err
 	}
 	defer resp.Body.Close()
 
@@ -219,8 +553,13 @@ func (h *Hetzner) UpdateRecord(zoneID, recordID, recordType, recordName, recordV
 	return nil
 }
 
+// Updates an existing DNS record with new information
+func (h *Hetzner) UpdateRecord(zoneID, recordID, recordType, recordName, recordValue string) error {
+	return h.UpdateRecordContext(context.Background(), zoneID, recordID, recordType, recordName, recordValue)
+}
+
 // Creates a new DNS record/value pair in the specified zone
-func (h *Hetzner) CreateRecord(zoneID, recordType, recordName, recordValue string) error {
+func (h *Hetzner) CreateRecordContext(ctx context.Context, zoneID, recordType, recordName, recordValue string) error {
 	url := fmt.Sprintf("%s/records", h.apiBaseURL())
 
 	newRecord := RecordUpdateRequest{
@@ -235,7 +574,7 @@ func (h *Hetzner) CreateRecord(zoneID, recordType, recordName, recordValue strin
 		return fmt.Errorf("failed to marshal request body: %w", err)
 	}
 
-	req, err := http.NewRequest("POST", url, bytes.NewBuffer(requestBody))
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(requestBody))
 	if err != nil {
 		return fmt.Errorf("failed to create request: %w", err)
 	}
@@ -243,10 +582,9 @@ func (h *Hetzner) CreateRecord(zoneID, recordType, recordName, recordValue strin
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Auth-API-Token", h.APIKey)
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := h.do(req)
 	if err != nil {
-		return fmt.Errorf("failed to execute request: %w", err)
+		return err
 	}
 	defer resp.Body.Close()
 
@@ -257,8 +595,13 @@ func (h *Hetzner) CreateRecord(zoneID, recordType, recordName, recordValue strin
 	return nil
 }
 
+// Creates a new DNS record/value pair in the specified zone
+func (h *Hetzner) CreateRecord(zoneID, recordType, recordName, recordValue string) error {
+	return h.CreateRecordContext(context.Background(), zoneID, recordType, recordName, recordValue)
+}
+
 // Creates a new bulk of DNS record/value pairs in the specified zone
-func (h *Hetzner) BulkCreateRecord(zoneID string, records []RecordUpdateRequest) error {
+func (h *Hetzner) BulkCreateRecordContext(ctx context.Context, zoneID string, records []RecordUpdateRequest) error {
 	url := fmt.Sprintf("%s/records/bulk", h.apiBaseURL())
 
 	bulkRequest := BulkRecordUpdateRequest{}
@@ -269,7 +612,7 @@ func (h *Hetzner) BulkCreateRecord(zoneID string, records []RecordUpdateRequest)
 		return fmt.Errorf("failed to marshal request body: %w", err)
 	}
 
-	req, err := http.NewRequest("POST", url, bytes.NewBuffer(requestBody))
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(requestBody))
 	if err != nil {
 		return fmt.Errorf("failed to create request: %w", err)
 	}
@@ -277,10 +620,9 @@ func (h *Hetzner) BulkCreateRecord(zoneID string, records []RecordUpdateRequest)
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Auth-API-Token", h.APIKey)
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := h.do(req)
 	if err != nil {
-		return fmt.Errorf("failed to execute request: %w", err)
+		return err
 	}
 	defer resp.Body.Close()
 
@@ -291,8 +633,13 @@ func (h *Hetzner) BulkCreateRecord(zoneID string, records []RecordUpdateRequest)
 	return nil
 }
 
+// Creates a new bulk of DNS record/value pairs in the specified zone
+func (h *Hetzner) BulkCreateRecord(zoneID string, records []RecordUpdateRequest) error {
+	return h.BulkCreateRecordContext(context.Background(), zoneID, records)
+}
+
 // Updates a bulk of DNS record/value pairs in the specified zone. Specifying record ID is required for this to work!
-func (h *Hetzner) BulkUpdateRecord(zoneID string, records []RecordUpdateRequest) error {
+func (h *Hetzner) BulkUpdateRecordContext(ctx context.Context, zoneID string, records []RecordUpdateRequest) error {
 	url := fmt.Sprintf("%s/records/bulk", h.apiBaseURL())
 
 	bulkRequest := BulkRecordUpdateRequest{}
@@ -303,7 +650,7 @@ func (h *Hetzner) BulkUpdateRecord(zoneID string, records []RecordUpdateRequest)
 		return fmt.Errorf("failed to marshal request body: %w", err)
 	}
 
-	req, err := http.NewRequest("PUT", url, bytes.NewBuffer(requestBody))
+	req, err := http.NewRequestWithContext(ctx, "PUT", url, bytes.NewBuffer(requestBody))
 	if err != nil {
 		return fmt.Errorf("failed to create request: %w", err)
 	}
@@ -311,10 +658,9 @@ func (h *Hetzner) BulkUpdateRecord(zoneID string, records []RecordUpdateRequest)
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Auth-API-Token", h.APIKey)
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := h.do(req)
 	if err != nil {
-		return fmt.Errorf("failed to execute request: %w", err)
+		return err
 	}
 	defer resp.Body.Close()
 
@@ -325,25 +671,244 @@ func (h *Hetzner) BulkUpdateRecord(zoneID string, records []RecordUpdateRequest)
 	return nil
 }
 
+// Updates a bulk of DNS record/value pairs in the specified zone. Specifying record ID is required for this to work!
+func (h *Hetzner) BulkUpdateRecord(zoneID string, records []RecordUpdateRequest) error {
+	return h.BulkUpdateRecordContext(context.Background(), zoneID, records)
+}
+
 // Checks if a record name/value pair already exists and updates it if it does. Otherwise, this creates a new record with the specified information.
-func (h *Hetzner) CreateOrUpdateRecord(zoneID, recordType, recordName, recordValue string) error {
-	records, err := h.FindRecordsByName(zoneID, recordName)
+func (h *Hetzner) CreateOrUpdateRecordContext(ctx context.Context, zoneID, recordType, recordName, recordValue string) error {
+	records, err := h.FindRecordsByNameContext(ctx, zoneID, recordName)
 	if err != nil {
 		return err
 	}
 	if len(records) > 0 {
 		record := records[0]
-		return h.UpdateRecord(zoneID, record.ID, record.Type, record.Name, recordValue)
+		return h.UpdateRecordContext(ctx, zoneID, record.ID, record.Type, record.Name, recordValue)
 	} else {
-		return h.CreateRecord(zoneID, recordType, recordName, recordValue)
+		return h.CreateRecordContext(ctx, zoneID, recordType, recordName, recordValue)
 	}
 }
 
+// Checks if a record name/value pair already exists and updates it if it does. Otherwise, this creates a new record with the specified information.
+func (h *Hetzner) CreateOrUpdateRecord(zoneID, recordType, recordName, recordValue string) error {
+	return h.CreateOrUpdateRecordContext(context.Background(), zoneID, recordType, recordName, recordValue)
+}
+
+// multiValueRecordTypes lists record types for which a name may legitimately resolve to
+// several distinct values at once; matching for these keys on (Type, Name, Value) instead
+// of just (Type, Name) so ReconcileZone doesn't treat one of several desired values as an
+// update to another.
+var multiValueRecordTypes = map[string]bool{
+	"TXT": true,
+	"MX":  true,
+	"NS":  true,
+}
+
+// recordKey identifies a record for ReconcileZone's diffing purposes.
+type recordKey struct {
+	Type  string
+	Name  string
+	Value string
+}
+
+func recordKeyFor(recordType, name, value string) recordKey {
+	key := recordKey{Type: strings.ToUpper(recordType), Name: strings.ToLower(name)}
+	if multiValueRecordTypes[key.Type] {
+		key.Value = value
+	}
+	return key
+}
+
+// ReconcilePlan describes the create, update, and delete operations needed to bring a zone's
+// records in line with a desired set.
+type ReconcilePlan struct {
+	Create []RecordUpdateRequest
+	Update []RecordUpdateRequest
+	Delete []RecordResponse
+}
+
+// DuplicateAction controls how ReconcileZoneContext handles desired entries that collide on
+// the same record key (e.g. two A records with the same name but different values).
+type DuplicateAction int
+
+const (
+	// DuplicateKeepFirst keeps the first desired entry for a colliding key and reports the
+	// rest via ReconcileResult.Duplicates. This is the zero value.
+	DuplicateKeepFirst DuplicateAction = iota
+	// DuplicateError fails the reconcile as soon as a colliding desired entry is found.
+	DuplicateError
+)
+
+// ReconcileOptions configures ReconcileZoneContext's diff-and-apply behavior.
+type ReconcileOptions struct {
+	// DryRun computes and returns the plan without executing it.
+	DryRun bool
+	// Prune allows stale records (present but not in the desired set) to be deleted. Without
+	// it, ReconcileZoneContext only ever creates and updates.
+	Prune bool
+	// IgnoreTypes excludes record types (e.g. "SOA", "NS" at the zone apex) from diffing
+	// entirely; matching current records are left untouched and matching desired records are
+	// skipped.
+	IgnoreTypes []string
+	// OnDuplicate controls what happens when desired contains two entries that collide on the
+	// same match key. The zero value, DuplicateKeepFirst, keeps the first and reports the rest;
+	// DuplicateError fails the reconcile instead.
+	OnDuplicate DuplicateAction
+}
+
+// ReconcileResult is returned by ReconcileZoneContext, always including the computed plan so
+// callers can log what changed (or, in dry-run mode, what would have changed).
+type ReconcileResult struct {
+	Plan ReconcilePlan
+	// Duplicates holds desired entries that collided on the same match key with an earlier
+	// entry and were dropped because of DuplicateKeepFirst.
+	Duplicates []RecordUpdateRequest
+	// Applied reports whether any create, update, or delete call was issued against the API.
+	// It is set as soon as a mutating call is attempted, even if that call (or a later one in
+	// the same plan) ultimately fails, since earlier calls may already have taken effect.
+	Applied bool
+}
+
+// ReconcileZoneContext diffs desired against the zone's current records and applies the
+// result via BulkCreateRecordContext, BulkUpdateRecordContext, and parallel DeleteRecordContext
+// calls. Records are matched on (Type, Name) for single-value types and (Type, Name, Value) for
+// multi-value types (TXT, MX, NS), so that several desired values for the same name don't
+// collide. With opts.DryRun the plan is computed and returned without being applied.
+func (h *Hetzner) ReconcileZoneContext(ctx context.Context, zoneID string, desired []RecordUpdateRequest, opts ReconcileOptions) (*ReconcileResult, error) {
+	var current []RecordResponse
+	err := h.IterateAllRecordsForZone(ctx, zoneID, func(record RecordResponse) error {
+		current = append(current, record)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list current records: %w", err)
+	}
+
+	ignored := make(map[string]bool, len(opts.IgnoreTypes))
+	for _, recordType := range opts.IgnoreTypes {
+		ignored[strings.ToUpper(recordType)] = true
+	}
+
+	currentByKey := make(map[recordKey]RecordResponse, len(current))
+	for _, record := range current {
+		if ignored[strings.ToUpper(record.Type)] {
+			continue
+		}
+		currentByKey[recordKeyFor(record.Type, record.Name, record.Value)] = record
+	}
+
+	var plan ReconcilePlan
+	var duplicates []RecordUpdateRequest
+	seen := make(map[recordKey]bool, len(desired))
+
+	for _, want := range desired {
+		if ignored[strings.ToUpper(want.Type)] {
+			continue
+		}
+
+		key := recordKeyFor(want.Type, want.Name, want.Value)
+		if seen[key] {
+			if opts.OnDuplicate == DuplicateError {
+				return nil, fmt.Errorf("duplicate desired record for type %s name %s", want.Type, want.Name)
+			}
+			duplicates = append(duplicates, want)
+			continue
+		}
+		seen[key] = true
+
+		if existing, ok := currentByKey[key]; ok {
+			if existing.Value != want.Value {
+				update := want
+				update.ID = existing.ID
+				update.ZoneID = zoneID
+				plan.Update = append(plan.Update, update)
+			}
+			continue
+		}
+
+		create := want
+		create.ZoneID = zoneID
+		plan.Create = append(plan.Create, create)
+	}
+
+	if opts.Prune {
+		for key, existing := range currentByKey {
+			if !seen[key] {
+				plan.Delete = append(plan.Delete, existing)
+			}
+		}
+	}
+
+	result := &ReconcileResult{Plan: plan, Duplicates: duplicates}
+	if opts.DryRun {
+		return result, nil
+	}
+
+	result.Applied = len(plan.Create) > 0 || len(plan.Update) > 0 || len(plan.Delete) > 0
+
+	if len(plan.Create) > 0 {
+		if err := h.BulkCreateRecordContext(ctx, zoneID, plan.Create); err != nil {
+			return result, fmt.Errorf("failed to create records: %w", err)
+		}
+	}
+	if len(plan.Update) > 0 {
+		if err := h.BulkUpdateRecordContext(ctx, zoneID, plan.Update); err != nil {
+			return result, fmt.Errorf("failed to update records: %w", err)
+		}
+	}
+	if len(plan.Delete) > 0 {
+		if err := h.deleteRecordsParallel(ctx, plan.Delete); err != nil {
+			return result, err
+		}
+	}
+
+	return result, nil
+}
+
+// maxParallelDeletes caps the number of DeleteRecordContext calls deleteRecordsParallel has
+// in flight at once, so pruning a large zone doesn't fire hundreds of simultaneous requests.
+const maxParallelDeletes = 10
+
+// deleteRecordsParallel issues a DeleteRecordContext call per record, up to maxParallelDeletes
+// at a time, returning the first error encountered (if any) once every call has completed.
+func (h *Hetzner) deleteRecordsParallel(ctx context.Context, records []RecordResponse) error {
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var firstErr error
+	sem := make(chan struct{}, maxParallelDeletes)
+
+	for _, record := range records {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(record RecordResponse) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			if err := h.DeleteRecordContext(ctx, record.ID); err != nil {
+				mu.Lock()
+				if firstErr == nil {
+					firstErr = fmt.Errorf("failed to delete record %s: %w", record.ID, err)
+				}
+				mu.Unlock()
+			}
+		}(record)
+	}
+
+	wg.Wait()
+	return firstErr
+}
+
+// ReconcileZone diffs desired against the zone's current records and applies the result. See
+// ReconcileZoneContext for details.
+func (h *Hetzner) ReconcileZone(zoneID string, desired []RecordUpdateRequest, opts ReconcileOptions) (*ReconcileResult, error) {
+	return h.ReconcileZoneContext(context.Background(), zoneID, desired, opts)
+}
+
 // Deletes a DNS record given its ID
-func (h *Hetzner) DeleteRecord(recordID string) error {
+func (h *Hetzner) DeleteRecordContext(ctx context.Context, recordID string) error {
 	url := fmt.Sprintf("%s/records/%s", h.apiBaseURL(), recordID)
 
-	req, err := http.NewRequest("DELETE", url, nil)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
 	if err != nil {
 		return fmt.Errorf("failed to create request: %w", err)
 	}
@@ -351,25 +916,29 @@ func (h *Hetzner) DeleteRecord(recordID string) error {
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Auth-API-Token", h.APIKey)
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := h.do(req)
 	if err != nil {
-		return fmt.Errorf("failed to execute request: %w", err)
+		return err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("API request failed with status %d", resp.StatusCode)
+		return h.createApiErrorMessage(resp)
 	}
 
 	return nil
 }
 
+// Deletes a DNS record given its ID
+func (h *Hetzner) DeleteRecord(recordID string) error {
+	return h.DeleteRecordContext(context.Background(), recordID)
+}
+
 // Exports the given DNS zone. If successful, the method returns a byte array with the file contents in it
-func (h *Hetzner) ExportZoneFile(zoneID string) ([]byte, error) {
+func (h *Hetzner) ExportZoneFileContext(ctx context.Context, zoneID string) ([]byte, error) {
 	url := fmt.Sprintf("%s/zones/%s/export", h.apiBaseURL(), zoneID)
 
-	req, err := http.NewRequest("GET", url, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
@@ -377,22 +946,26 @@ func (h *Hetzner) ExportZoneFile(zoneID string) ([]byte, error) {
 	req.Header.Add("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
 	req.Header.Set("Auth-API-Token", h.APIKey)
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := h.do(req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to execute request: %w", err)
+		return nil, err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("API request failed with status %d", resp.StatusCode)
+		return nil, h.createApiErrorMessage(resp)
 	}
 
 	return io.ReadAll(resp.Body)
 }
 
+// Exports the given DNS zone. If successful, the method returns a byte array with the file contents in it
+func (h *Hetzner) ExportZoneFile(zoneID string) ([]byte, error) {
+	return h.ExportZoneFileContext(context.Background(), zoneID)
+}
+
 // Validates a given DNS zone file for validity
-func (h *Hetzner) ValidateZoneFile(zoneFile string) error {
+func (h *Hetzner) ValidateZoneFileContext(ctx context.Context, zoneFile string) error {
 	url := fmt.Sprintf("%s/zones/file/validate", h.apiBaseURL())
 
 	requestBody, err := os.ReadFile(zoneFile)
@@ -400,7 +973,7 @@ func (h *Hetzner) ValidateZoneFile(zoneFile string) error {
 		return fmt.Errorf("failed to read zone file: %w", err)
 	}
 
-	req, err := http.NewRequest("POST", url, bytes.NewBuffer(requestBody))
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(requestBody))
 	if err != nil {
 		return fmt.Errorf("failed to create request: %w", err)
 	}
@@ -408,10 +981,9 @@ func (h *Hetzner) ValidateZoneFile(zoneFile string) error {
 	req.Header.Add("Content-Type", "text/plain")
 	req.Header.Set("Auth-API-Token", h.APIKey)
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := h.do(req)
 	if err != nil {
-		return fmt.Errorf("failed to execute request: %w", err)
+		return err
 	}
 	defer resp.Body.Close()
 
@@ -422,8 +994,13 @@ func (h *Hetzner) ValidateZoneFile(zoneFile string) error {
 	return nil
 }
 
+// Validates a given DNS zone file for validity
+func (h *Hetzner) ValidateZoneFile(zoneFile string) error {
+	return h.ValidateZoneFileContext(context.Background(), zoneFile)
+}
+
 // Imports a given DNS zone file
-func (h *Hetzner) ImportZoneFile(zoneID, zoneFile string) error {
+func (h *Hetzner) ImportZoneFileContext(ctx context.Context, zoneID, zoneFile string) error {
 	url := fmt.Sprintf("%s/zones/%s/import", h.apiBaseURL(), zoneID)
 
 	requestBody, err := os.ReadFile(zoneFile)
@@ -431,7 +1008,7 @@ func (h *Hetzner) ImportZoneFile(zoneID, zoneFile string) error {
 		return fmt.Errorf("failed to read zone file: %w", err)
 	}
 
-	req, err := http.NewRequest("POST", url, bytes.NewBuffer(requestBody))
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(requestBody))
 	if err != nil {
 		return fmt.Errorf("failed to create request: %w", err)
 	}
@@ -439,10 +1016,9 @@ func (h *Hetzner) ImportZoneFile(zoneID, zoneFile string) error {
 	req.Header.Add("Content-Type", "text/plain")
 	req.Header.Set("Auth-API-Token", h.APIKey)
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := h.do(req)
 	if err != nil {
-		return fmt.Errorf("failed to execute request: %w", err)
+		return err
 	}
 	defer resp.Body.Close()
 
@@ -453,21 +1029,25 @@ func (h *Hetzner) ImportZoneFile(zoneID, zoneFile string) error {
 	return nil
 }
 
+// Imports a given DNS zone file
+func (h *Hetzner) ImportZoneFile(zoneID, zoneFile string) error {
+	return h.ImportZoneFileContext(context.Background(), zoneID, zoneFile)
+}
+
 // Lists all the available Primary Servers
-func (h *Hetzner) FindAllPrimaryServers() (PrimaryServers, error) {
+func (h *Hetzner) FindAllPrimaryServersContext(ctx context.Context) (PrimaryServers, error) {
 	url := fmt.Sprintf("%s/primary_servers", h.apiBaseURL())
 
-	req, err := http.NewRequest("GET", url, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return PrimaryServers{}, fmt.Errorf("failed to create request: %w", err)
 	}
 
 	req.Header.Set("Auth-API-Token", h.APIKey)
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := h.do(req)
 	if err != nil {
-		return PrimaryServers{}, fmt.Errorf("failed to execute request: %w", err)
+		return PrimaryServers{}, err
 	}
 	defer resp.Body.Close()
 
@@ -482,8 +1062,13 @@ func (h *Hetzner) FindAllPrimaryServers() (PrimaryServers, error) {
 	return primaryServers, nil
 }
 
+// Lists all the available Primary Servers
+func (h *Hetzner) FindAllPrimaryServers() (PrimaryServers, error) {
+	return h.FindAllPrimaryServersContext(context.Background())
+}
+
 // Creates a new primary server
-func (h *Hetzner) CreatePrimaryServer(zoneID string, address string, port int) error {
+func (h *Hetzner) CreatePrimaryServerContext(ctx context.Context, zoneID string, address string, port int) error {
 	url := fmt.Sprintf("%s/primary_servers", h.apiBaseURL())
 
 	var primaryServer = PrimaryServer{}
@@ -496,7 +1081,7 @@ func (h *Hetzner) CreatePrimaryServer(zoneID string, address string, port int) e
 		return fmt.Errorf("failed to marshal request body: %w", err)
 	}
 
-	req, err := http.NewRequest("POST", url, bytes.NewBuffer(requestBody))
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(requestBody))
 	if err != nil {
 		return fmt.Errorf("failed to create request: %w", err)
 	}
@@ -504,10 +1089,9 @@ func (h *Hetzner) CreatePrimaryServer(zoneID string, address string, port int) e
 	req.Header.Add("Content-Type", "application/json")
 	req.Header.Set("Auth-API-Token", h.APIKey)
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := h.do(req)
 	if err != nil {
-		return fmt.Errorf("failed to execute request: %w", err)
+		return err
 	}
 	defer resp.Body.Close()
 
@@ -518,8 +1102,13 @@ func (h *Hetzner) CreatePrimaryServer(zoneID string, address string, port int) e
 	return nil
 }
 
+// Creates a new primary server
+func (h *Hetzner) CreatePrimaryServer(zoneID string, address string, port int) error {
+	return h.CreatePrimaryServerContext(context.Background(), zoneID, address, port)
+}
+
 // Updates an existing primary server
-func (h *Hetzner) UpdatePrimaryServer(zoneID string, id string, address string, port int) error {
+func (h *Hetzner) UpdatePrimaryServerContext(ctx context.Context, zoneID string, id string, address string, port int) error {
 	url := fmt.Sprintf("%s/primary_servers", h.apiBaseURL())
 
 	var primaryServer = PrimaryServer{}
@@ -533,7 +1122,7 @@ func (h *Hetzner) UpdatePrimaryServer(zoneID string, id string, address string,
 		return fmt.Errorf("failed to marshal request body: %w", err)
 	}
 
-	req, err := http.NewRequest("PUT", url, bytes.NewBuffer(requestBody))
+	req, err := http.NewRequestWithContext(ctx, "PUT", url, bytes.NewBuffer(requestBody))
 	if err != nil {
 		return fmt.Errorf("failed to create request: %w", err)
 	}
@@ -541,10 +1130,9 @@ func (h *Hetzner) UpdatePrimaryServer(zoneID string, id string, address string,
 	req.Header.Add("Content-Type", "application/json")
 	req.Header.Set("Auth-API-Token", h.APIKey)
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := h.do(req)
 	if err != nil {
-		return fmt.Errorf("failed to execute request: %w", err)
+		return err
 	}
 	defer resp.Body.Close()
 
@@ -555,11 +1143,16 @@ func (h *Hetzner) UpdatePrimaryServer(zoneID string, id string, address string,
 	return nil
 }
 
+// Updates an existing primary server
+func (h *Hetzner) UpdatePrimaryServer(zoneID string, id string, address string, port int) error {
+	return h.UpdatePrimaryServerContext(context.Background(), zoneID, id, address, port)
+}
+
 // Gets a primary server identified by ID
-func (h *Hetzner) GetPrimaryServer(id string) (PrimaryServer, error) {
+func (h *Hetzner) GetPrimaryServerContext(ctx context.Context, id string) (PrimaryServer, error) {
 	url := fmt.Sprintf("%s/primary_servers/%s", h.apiBaseURL(), id)
 
-	req, err := http.NewRequest("GET", url, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return PrimaryServer{}, fmt.Errorf("failed to create request: %w", err)
 	}
@@ -567,10 +1160,9 @@ func (h *Hetzner) GetPrimaryServer(id string) (PrimaryServer, error) {
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Auth-API-Token", h.APIKey)
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := h.do(req)
 	if err != nil {
-		return PrimaryServer{}, fmt.Errorf("failed to execute request: %w", err)
+		return PrimaryServer{}, err
 	}
 	defer resp.Body.Close()
 
@@ -585,11 +1177,16 @@ func (h *Hetzner) GetPrimaryServer(id string) (PrimaryServer, error) {
 	return primaryServer, nil
 }
 
+// Gets a primary server identified by ID
+func (h *Hetzner) GetPrimaryServer(id string) (PrimaryServer, error) {
+	return h.GetPrimaryServerContext(context.Background(), id)
+}
+
 // Deletes a primary server
-func (h *Hetzner) DeletePrimaryServer(id string) (PrimaryServer, error) {
+func (h *Hetzner) DeletePrimaryServerContext(ctx context.Context, id string) (PrimaryServer, error) {
 	url := fmt.Sprintf("%s/primary_servers/%s", h.apiBaseURL(), id)
 
-	req, err := http.NewRequest("DELETE", url, nil)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
 	if err != nil {
 		return PrimaryServer{}, fmt.Errorf("failed to create request: %w", err)
 	}
@@ -597,10 +1194,9 @@ func (h *Hetzner) DeletePrimaryServer(id string) (PrimaryServer, error) {
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Auth-API-Token", h.APIKey)
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := h.do(req)
 	if err != nil {
-		return PrimaryServer{}, fmt.Errorf("failed to execute request: %w", err)
+		return PrimaryServer{}, err
 	}
 	defer resp.Body.Close()
 
@@ -615,6 +1211,11 @@ func (h *Hetzner) DeletePrimaryServer(id string) (PrimaryServer, error) {
 	return primaryServer, nil
 }
 
+// Deletes a primary server
+func (h *Hetzner) DeletePrimaryServer(id string) (PrimaryServer, error) {
+	return h.DeletePrimaryServerContext(context.Background(), id)
+}
+
 // Helper method to return base url of the api (or default value if it wasn't set)
 func (h *Hetzner) apiBaseURL() string {
 	if len(h.APIBaseUrl) > 0 {
@@ -623,11 +1224,181 @@ func (h *Hetzner) apiBaseURL() string {
 	return "https://dns.hetzner.com/api/v1"
 }
 
+// Helper method to return the http.Client to use for requests (or a shared default)
+func (h *Hetzner) httpClient() *http.Client {
+	if h.HTTPClient != nil {
+		return h.HTTPClient
+	}
+	return defaultHTTPClient
+}
+
+// Helper method to return the retry budget to use for requests (or a sensible default)
+func (h *Hetzner) maxRetries() int {
+	if h.MaxRetries > 0 {
+		return h.MaxRetries
+	}
+	return defaultMaxRetries
+}
+
+// do executes req, retrying rate-limited (429), transiently unavailable (5xx), and
+// network-level failures with exponential backoff and jitter, honoring any Retry-After
+// header along the way. GET and DELETE requests are always eligible for retry; POST and PUT
+// requests are only retried when h.RetryPolicy opts into them, since those may not be
+// idempotent on Hetzner's side. Callers are responsible for closing the returned response body.
+func (h *Hetzner) do(req *http.Request) (*http.Response, error) {
+	if h.UserAgent != "" {
+		req.Header.Set("User-Agent", h.UserAgent)
+	}
+
+	retryable := h.isRetryable(req.Method)
+	maxRetries := h.maxRetries()
+
+	var lastErr error
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, fmt.Errorf("failed to rewind request body: %w", err)
+			}
+			req.Body = body
+		}
+
+		start := time.Now()
+		resp, err := h.httpClient().Do(req)
+		duration := time.Since(start)
+
+		if err != nil {
+			h.logWarnf("hetzner: %s %s failed after %s: %v", req.Method, req.URL, duration, err)
+			lastErr = err
+			if !retryable || attempt >= maxRetries {
+				return nil, fmt.Errorf("failed to execute request: %w", err)
+			}
+			if !sleepWithContext(req.Context(), backoffDelay(attempt, 0)) {
+				return nil, fmt.Errorf("failed to execute request: %w", lastErr)
+			}
+			continue
+		}
+
+		h.logInfof("hetzner: %s %s -> %d (%s)", req.Method, req.URL, resp.StatusCode, duration)
+
+		if !retryable || attempt >= maxRetries || !isRetryableStatus(resp.StatusCode) {
+			return resp, nil
+		}
+
+		wait := backoffDelay(attempt, retryAfterDelay(resp))
+		resp.Body.Close()
+		h.logDebugf("hetzner: retrying %s %s after %s (attempt %d/%d)", req.Method, req.URL, wait, attempt+1, maxRetries)
+		if !sleepWithContext(req.Context(), wait) {
+			return nil, req.Context().Err()
+		}
+	}
+}
+
+// logDebugf, logInfof, and logWarnf forward to h.Logger if one is configured, and are no-ops
+// otherwise. Request headers (including the API token) are never passed to the logger.
+func (h *Hetzner) logDebugf(format string, args ...interface{}) {
+	if h.Logger != nil {
+		h.Logger.Debugf(format, args...)
+	}
+}
+
+func (h *Hetzner) logInfof(format string, args ...interface{}) {
+	if h.Logger != nil {
+		h.Logger.Infof(format, args...)
+	}
+}
+
+func (h *Hetzner) logWarnf(format string, args ...interface{}) {
+	if h.Logger != nil {
+		h.Logger.Warnf(format, args...)
+	}
+}
+
+// isRetryable reports whether requests using the given HTTP method are eligible for retry.
+func (h *Hetzner) isRetryable(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodDelete:
+		return true
+	case http.MethodPost:
+		return h.RetryPolicy.RetryPOST
+	case http.MethodPut:
+		return h.RetryPolicy.RetryPUT
+	default:
+		return false
+	}
+}
+
+// isRetryableStatus reports whether resp's status code warrants a retry.
+func isRetryableStatus(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests || statusCode == http.StatusServiceUnavailable || statusCode >= 500
+}
+
+// retryAfterDelay parses the Retry-After header (seconds or HTTP-date form), returning zero
+// if it is absent or unparsable.
+func retryAfterDelay(resp *http.Response) time.Duration {
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+	if when, err := http.ParseTime(value); err == nil {
+		return time.Until(when)
+	}
+	return 0
+}
+
+// backoffDelay computes an exponentially increasing delay with jitter for the given attempt
+// number, never returning less than floor (typically a server-provided Retry-After value).
+func backoffDelay(attempt int, floor time.Duration) time.Duration {
+	base := 250 * time.Millisecond * time.Duration(1<<uint(attempt))
+	delay := base + time.Duration(rand.Int63n(int64(base)+1))
+	if floor > delay {
+		return floor
+	}
+	return delay
+}
+
+// sleepWithContext waits for d or until ctx is done, returning false if ctx was cancelled first.
+func sleepWithContext(ctx context.Context, d time.Duration) bool {
+	if d <= 0 {
+		return ctx.Err() == nil
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
 // Helper method to create an api error message
 func (h *Hetzner) createApiErrorMessage(resp *http.Response) error {
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
 		return err
 	}
-	return fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		Message:    string(body),
+		RequestID:  resp.Header.Get("X-Correlation-Id"),
+	}
+
+	var parsed struct {
+		Message string `json:"message"`
+		Error   string `json:"error"`
+	}
+	if json.Unmarshal(body, &parsed) == nil {
+		if parsed.Message != "" {
+			apiErr.Message = parsed.Message
+		} else if parsed.Error != "" {
+			apiErr.Message = parsed.Error
+		}
+	}
+
+	return apiErr
 }
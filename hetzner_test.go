@@ -0,0 +1,191 @@
+package hetzner
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestRecordKeyFor(t *testing.T) {
+	tests := []struct {
+		name       string
+		recordType string
+		recordName string
+		value      string
+		want       recordKey
+	}{
+		{
+			name:       "single-value type ignores value",
+			recordType: "A",
+			recordName: "WWW",
+			value:      "1.2.3.4",
+			want:       recordKey{Type: "A", Name: "www"},
+		},
+		{
+			name:       "multi-value type keys on value too",
+			recordType: "txt",
+			recordName: "@",
+			value:      "hello",
+			want:       recordKey{Type: "TXT", Name: "@", Value: "hello"},
+		},
+		{
+			name:       "MX is multi-value",
+			recordType: "MX",
+			recordName: "@",
+			value:      "10 mail.example.com.",
+			want:       recordKey{Type: "MX", Name: "@", Value: "10 mail.example.com."},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := recordKeyFor(tt.recordType, tt.recordName, tt.value)
+			if got != tt.want {
+				t.Errorf("recordKeyFor(%q, %q, %q) = %+v, want %+v", tt.recordType, tt.recordName, tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBackoffDelay(t *testing.T) {
+	tests := []struct {
+		name    string
+		attempt int
+		floor   time.Duration
+		minWant time.Duration
+	}{
+		{name: "first attempt has no floor", attempt: 0, floor: 0, minWant: 250 * time.Millisecond},
+		{name: "floor wins over a small base", attempt: 0, floor: 10 * time.Second, minWant: 10 * time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := backoffDelay(tt.attempt, tt.floor)
+			if got < tt.minWant {
+				t.Errorf("backoffDelay(%d, %s) = %s, want >= %s", tt.attempt, tt.floor, got, tt.minWant)
+			}
+		})
+	}
+}
+
+func TestRetryAfterDelay(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		want   time.Duration
+	}{
+		{name: "absent header", header: "", want: 0},
+		{name: "seconds form", header: "5", want: 5 * time.Second},
+		{name: "unparsable value", header: "not-a-date", want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &http.Response{Header: http.Header{}}
+			if tt.header != "" {
+				resp.Header.Set("Retry-After", tt.header)
+			}
+			got := retryAfterDelay(resp)
+			if got != tt.want {
+				t.Errorf("retryAfterDelay(%q) = %s, want %s", tt.header, got, tt.want)
+			}
+		})
+	}
+}
+
+// newReconcileTestServer returns an httptest.Server that serves a single page of records for
+// zone "zone1" and answers any bulk create/update/delete call made against it with 200 OK,
+// without keeping or asserting on it.
+func newReconcileTestServer(t *testing.T, records []RecordResponse) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/records":
+			resp := struct {
+				Records []RecordResponse `json:"records"`
+				Meta    struct {
+					Pagination Pagination `json:"pagination"`
+				} `json:"meta"`
+			}{Records: records}
+			resp.Meta.Pagination = Pagination{Page: 1, LastPage: 1, PerPage: 100, TotalEntries: len(records)}
+			json.NewEncoder(w).Encode(resp)
+		case r.Method == http.MethodPost && r.URL.Path == "/records/bulk":
+			w.WriteHeader(http.StatusOK)
+		case r.Method == http.MethodPut && r.URL.Path == "/records/bulk":
+			w.WriteHeader(http.StatusOK)
+		case r.Method == http.MethodDelete:
+			w.WriteHeader(http.StatusOK)
+		default:
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+}
+
+func TestReconcileZoneContextDiff(t *testing.T) {
+	current := []RecordResponse{
+		{ID: "rec-keep", ZoneID: "zone1", Type: "A", Name: "www", Value: "1.1.1.1"},
+		{ID: "rec-update", ZoneID: "zone1", Type: "A", Name: "api", Value: "2.2.2.2"},
+		{ID: "rec-stale", ZoneID: "zone1", Type: "A", Name: "old", Value: "3.3.3.3"},
+	}
+
+	desired := []RecordUpdateRequest{
+		{Type: "A", Name: "www", Value: "1.1.1.1"}, // unchanged
+		{Type: "A", Name: "api", Value: "9.9.9.9"}, // updated
+		{Type: "A", Name: "new", Value: "4.4.4.4"}, // created
+	}
+
+	server := newReconcileTestServer(t, current)
+	defer server.Close()
+
+	h := &Hetzner{APIKey: "key", APIBaseUrl: server.URL}
+
+	result, err := h.ReconcileZoneContext(context.Background(), "zone1", desired, ReconcileOptions{DryRun: true, Prune: true})
+	if err != nil {
+		t.Fatalf("ReconcileZoneContext returned error: %v", err)
+	}
+
+	if len(result.Plan.Create) != 1 || result.Plan.Create[0].Name != "new" {
+		t.Errorf("Plan.Create = %+v, want one record named %q", result.Plan.Create, "new")
+	}
+	if len(result.Plan.Update) != 1 || result.Plan.Update[0].Name != "api" {
+		t.Errorf("Plan.Update = %+v, want one record named %q", result.Plan.Update, "api")
+	}
+	if len(result.Plan.Delete) != 1 || result.Plan.Delete[0].Name != "old" {
+		t.Errorf("Plan.Delete = %+v, want one record named %q", result.Plan.Delete, "old")
+	}
+	if result.Applied {
+		t.Error("Applied = true for a DryRun result, want false")
+	}
+}
+
+func TestReconcileZoneContextDuplicates(t *testing.T) {
+	server := newReconcileTestServer(t, nil)
+	defer server.Close()
+
+	h := &Hetzner{APIKey: "key", APIBaseUrl: server.URL}
+
+	desired := []RecordUpdateRequest{
+		{Type: "A", Name: "www", Value: "1.1.1.1"},
+		{Type: "A", Name: "www", Value: "2.2.2.2"},
+	}
+
+	t.Run("DuplicateKeepFirst reports the dropped entry", func(t *testing.T) {
+		result, err := h.ReconcileZoneContext(context.Background(), "zone1", desired, ReconcileOptions{DryRun: true})
+		if err != nil {
+			t.Fatalf("ReconcileZoneContext returned error: %v", err)
+		}
+		if len(result.Duplicates) != 1 || result.Duplicates[0].Value != "2.2.2.2" {
+			t.Errorf("Duplicates = %+v, want one entry with value %q", result.Duplicates, "2.2.2.2")
+		}
+	})
+
+	t.Run("DuplicateError fails the reconcile", func(t *testing.T) {
+		_, err := h.ReconcileZoneContext(context.Background(), "zone1", desired, ReconcileOptions{DryRun: true, OnDuplicate: DuplicateError})
+		if err == nil {
+			t.Error("ReconcileZoneContext returned no error, want one for a colliding duplicate")
+		}
+	})
+}